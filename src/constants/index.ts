@@ -12,6 +12,9 @@ export const MAX_SUGGESTIONS = 5
 /** Solver computation timeout in milliseconds */
 export const SOLVER_TIMEOUT_MS = 30000
 
+/** Number of recently solved game states to keep suggestions for */
+export const SUGGESTION_CACHE_SIZE = 32
+
 /** Cookie names for persisting user preferences */
 export const DARK_MODE_COOKIE = 'wordle_ai_dark_mode'
 export const STRICT_GUESSES_COOKIE = 'wordle_ai_strict_guesses'
@@ -4,15 +4,21 @@ import { WORDLIST_PATH, WORD_LENGTH } from '../constants'
 
 const logger = createLogger('useWordlists')
 
+/** A valid wordlist entry: exactly WORD_LENGTH uppercase letters */
+const VALID_WORD_PATTERN = new RegExp(`^[A-Z]{${WORD_LENGTH}}$`)
+
 /**
  * Custom hook for loading and caching Wordle wordlists
  * Loads sowpods_5.txt from public/wordlists/ for both answers
  * and guesses on app startup and caches them in state
+ * Also returns how many entries were loaded and how many were skipped
  */
 export function useWordlists() {
   const [answersList, setAnswersList] = useState<string[]>([])
   const [guessesList, setGuessesList] = useState<string[]>([])
   const [isLoaded, setIsLoaded] = useState(false)
+  const [loadedCount, setLoadedCount] = useState(0)
+  const [skippedCount, setSkippedCount] = useState(0)
   const [error, setError] = useState<string | null>(null)
 
   useEffect(() => {
@@ -32,18 +38,31 @@ export function useWordlists() {
         // Parse wordlist
         const text = await response.text()
 
-        const words = text
+        // Skip blank lines and reject anything that isn't exactly
+        // WORD_LENGTH A-Z letters instead of failing the whole load
+        const lines = text
           .split('\n')
           .map((w) => w.trim().toUpperCase())
-          .filter((w) => w.length === WORD_LENGTH)
+          .filter((w) => w.length > 0)
+        const words = lines.filter((w) => VALID_WORD_PATTERN.test(w))
+        const skipped = lines.length - words.length
+
+        if (skipped > 0) {
+          logger.warn('Skipped invalid wordlist entries', {
+            skippedCount: skipped,
+          })
+        }
 
         logger.info('SOWPODS_5 wordlist loaded successfully', {
           wordCount: words.length,
+          skippedCount: skipped,
         })
 
         // Use same wordlist for both answers and guesses
         setAnswersList(words)
         setGuessesList(words)
+        setLoadedCount(words.length)
+        setSkippedCount(skipped)
         setIsLoaded(true)
       } catch (err) {
         const errorMessage =
@@ -63,6 +82,8 @@ export function useWordlists() {
     answersList,
     guessesList,
     isLoaded,
+    loadedCount,
+    skippedCount,
     error,
   }
 }
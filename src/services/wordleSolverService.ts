@@ -1,7 +1,9 @@
 import { v4 as uuidv4 } from 'uuid'
 import { GameState, SuggestionItem } from '../types/index'
 import { createLogger } from '../utils/logger'
+import { getGameStateFingerprint } from '../utils/gameState'
 import initialSuggestionsData from '../data/initialSuggestions.json'
+import { SUGGESTION_CACHE_SIZE } from '../constants'
 
 const logger = createLogger('WordleSolverService')
 
@@ -11,6 +13,8 @@ export interface SuggestionResult {
   requestId: string
 }
 
+type CachedSuggestions = Omit<SuggestionResult, 'requestId'>
+
 /**
  * Service to manage Web Worker communication for Wordle solving
  * Handles worker lifecycle, initialization, and suggestion computation
@@ -31,6 +35,8 @@ export class WordleSolverService {
     string,
     ReturnType<typeof setTimeout>
   > = new Map()
+  // Least-recently-used first; Map preserves insertion order
+  private resultCache: Map<string, CachedSuggestions> = new Map()
 
   /**
    * Initialize the service with wordlists
@@ -104,6 +110,43 @@ export class WordleSolverService {
     return uuidv4()
   }
 
+  /**
+   * Build the result cache key for a game state and strategy choice
+   */
+  private getCacheKey(
+    gameState: GameState,
+    useStrictGuesses: boolean
+  ): string {
+    const strategy = useStrictGuesses ? 'strict' : 'all'
+    return `${strategy}/${getGameStateFingerprint(gameState)}`
+  }
+
+  /**
+   * Look up cached suggestions, marking the entry as recently used
+   */
+  private getCachedResult(key: string): CachedSuggestions | undefined {
+    const cached = this.resultCache.get(key)
+    if (cached) {
+      this.resultCache.delete(key)
+      this.resultCache.set(key, cached)
+    }
+    return cached
+  }
+
+  /**
+   * Cache suggestions, evicting the least recently used entry when full
+   */
+  private cacheResult(key: string, result: CachedSuggestions): void {
+    this.resultCache.delete(key)
+    this.resultCache.set(key, result)
+    if (this.resultCache.size > SUGGESTION_CACHE_SIZE) {
+      const oldestKey = this.resultCache.keys().next().value
+      if (oldestKey !== undefined) {
+        this.resultCache.delete(oldestKey)
+      }
+    }
+  }
+
   /**
    * Cancel the current request and forcibly reject its promise
    */
@@ -155,6 +198,7 @@ export class WordleSolverService {
    * Compute suggestions for a game state
    * Returns a promise that resolves with suggestions and remaining answers
    * Includes timeout handling and cancellation of stale requests
+   * Recently solved game states are served from an LRU cache
    * Note: Initial computation with all 2315 answers can take 10-20 seconds
    */
   async computeSuggestions(
@@ -201,6 +245,19 @@ export class WordleSolverService {
           return
         }
 
+        // Return cached suggestions if this state was solved recently
+        const cacheKey = this.getCacheKey(gameState, useStrictGuesses)
+        const cached = this.getCachedResult(cacheKey)
+        if (cached) {
+          logger.info('Returning cached suggestions', { requestId })
+          this.requestRejects.delete(requestId)
+          resolve({
+            ...cached,
+            requestId,
+          })
+          return
+        }
+
         const handler = (e: MessageEvent) => {
           // Only process if this is for the current request
           if (e.data.requestId !== requestId) {
@@ -222,6 +279,10 @@ export class WordleSolverService {
               clearTimeout(timeoutId)
               this.requestTimeouts.delete(requestId)
             }
+            this.cacheResult(cacheKey, {
+              suggestions: e.data.suggestions,
+              remainingAnswers: e.data.remainingAnswers,
+            })
             resolve({
               suggestions: e.data.suggestions,
               remainingAnswers: e.data.remainingAnswers,
@@ -278,6 +339,9 @@ export class WordleSolverService {
       this.worker = null
       this.initPromise = null
     }
+    // Cached results belong to the old wordlists; a later initialize()
+    // may load different ones
+    this.resultCache.clear()
   }
 }
 
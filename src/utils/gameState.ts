@@ -0,0 +1,27 @@
+import { GameState, LetterColor } from '../types/index'
+
+/**
+ * Single-letter codes for feedback colors in a fingerprint
+ */
+const COLOR_CODES: Record<LetterColor, string> = {
+  gray: 'B',
+  yellow: 'Y',
+  green: 'G',
+}
+
+/**
+ * Build a canonical fingerprint for a game state
+ * Serializes each guess (uppercased) with its feedback pattern in
+ * order, e.g. "TARES:BYBBG|CLOUD:GGBBB", so logically identical
+ * histories always produce the same string
+ */
+export function getGameStateFingerprint(gameState: GameState): string {
+  return gameState.history
+    .map((entry) => {
+      const pattern = entry.feedback.colors
+        .map((color) => COLOR_CODES[color])
+        .join('')
+      return `${entry.word.trim().toUpperCase()}:${pattern}`
+    })
+    .join('|')
+}
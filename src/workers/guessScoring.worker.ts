@@ -5,7 +5,10 @@
  * calculates information gain for each guess
  */
 
-import { getFeedback } from './wordleUtils'
+import {
+  feedbackCodeFor,
+  feedbackPatternCount,
+} from './wordleUtils'
 
 interface GuessScoringTask {
   guesses: string[]
@@ -17,16 +20,35 @@ interface GuessScoringResult {
 }
 
 /**
- * Calculate Shannon entropy for a set of equiprobable outcomes
+ * Partition sizes indexed by packed feedback code
+ * Reused across guesses and reset between them so the hot loop doesn't
+ * allocate a new partition map for every guess; reallocated only when
+ * the guess length (and so the number of feedback patterns) changes
  */
-function calculateEntropy(count: number): number {
-  if (count <= 1) return 0
-  const probability = 1.0 / count
-  return -count * probability * Math.log2(probability)
+let partitionCounts = new Int32Array(0)
+
+/**
+ * Calculate Shannon entropy of a partition of outcomes
+ * Computes -Σ p·log2(p) where p is each partition's share of the total
+ */
+function calculateEntropyFromPartitions(
+  partitions: Int32Array,
+  total: number
+): number {
+  let entropy = 0.0
+  for (const count of partitions) {
+    if (count > 0) {
+      const probability = count / total
+      entropy -= probability * Math.log2(probability)
+    }
+  }
+  return entropy
 }
 
 /**
  * Calculate information gain (entropy reduction) for a guess
+ * With equiprobable answers this equals the entropy of the feedback
+ * distribution: log2(n) - Σ p·log2(count) = -Σ p·log2(p)
  */
 function calculateInformationGain(
   guess: string,
@@ -34,32 +56,21 @@ function calculateInformationGain(
 ): number {
   if (possibleAnswers.length === 0) return 0
 
-  // Current entropy before the guess
-  const currentEntropy = calculateEntropy(possibleAnswers.length)
-
   // Partition answers by feedback pattern
-  const feedbackPartitions = new Map<string, number>()
-  for (const answer of possibleAnswers) {
-    const feedback = getFeedback(answer, guess)
-    const feedbackKey = feedback.join('')
-    feedbackPartitions.set(
-      feedbackKey,
-      (feedbackPartitions.get(feedbackKey) || 0) + 1
-    )
+  const patternCount = feedbackPatternCount(guess.length)
+  if (partitionCounts.length !== patternCount) {
+    partitionCounts = new Int32Array(patternCount)
   }
-
-  // Calculate expected entropy after the guess
-  let expectedEntropy = 0.0
-  const totalAnswers = possibleAnswers.length
-  for (const count of feedbackPartitions.values()) {
-    if (count > 0) {
-      const probability = count / totalAnswers
-      expectedEntropy += probability * calculateEntropy(count)
-    }
+  partitionCounts.fill(0)
+  for (const answer of possibleAnswers) {
+    partitionCounts[feedbackCodeFor(guess, answer)]++
   }
 
-  // Information gain = reduction in entropy
-  return currentEntropy - expectedEntropy
+  // Information gain = entropy of the feedback distribution
+  return calculateEntropyFromPartitions(
+    partitionCounts,
+    possibleAnswers.length
+  )
 }
 
 /**
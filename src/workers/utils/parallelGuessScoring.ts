@@ -6,6 +6,13 @@
 import { WorkerPool } from './WorkerPool'
 import guessScoringWorkerUrl from '../guessScoring.worker.ts?worker&url'
 
+/**
+ * Scores are rounded to this many decimal places before sorting, since
+ * equal partitions can sum to slightly different floating-point
+ * entropies and should still compare as tied
+ */
+const SCORE_DECIMAL_PLACES = 9
+
 export interface GuessScoringTask {
   guesses: string[]
   possibleAnswers: string[]
@@ -59,13 +66,30 @@ export class ParallelGuessScorer {
     const results = await Promise.all(scoringPromises)
 
     // Merge results from all workers
+    // Round scores once so float noise can't break ties inconsistently
+    const scale = 10 ** SCORE_DECIMAL_PLACES
     const allScores: Array<{ word: string; score: number }> = []
     for (const result of results) {
-      allScores.push(...result.scores)
+      for (const { word, score } of result.scores) {
+        allScores.push({ word, score: Math.round(score * scale) / scale })
+      }
     }
 
-    // Sort by information gain (descending)
-    allScores.sort((a, b) => b.score - a.score)
+    // Sort by information gain (descending), preferring guesses that
+    // are themselves possible answers on ties since they can win outright,
+    // then alphabetically so the order is always reproducible
+    const answerSet = new Set(possibleAnswers)
+    allScores.sort((a, b) => {
+      if (a.score !== b.score) {
+        return b.score - a.score
+      }
+      const membershipDiff =
+        Number(answerSet.has(b.word)) - Number(answerSet.has(a.word))
+      if (membershipDiff !== 0) {
+        return membershipDiff
+      }
+      return a.word < b.word ? -1 : a.word > b.word ? 1 : 0
+    })
 
     return allScores
   }
@@ -24,9 +24,18 @@ export interface GameState {
  * - GREEN = correct letter in correct position
  * - YELLOW = correct letter in wrong position
  * - GRAY = letter not in answer
+ * Works for words of any length, but both must be the same length
+ * @throws Error if answer and guess lengths differ
  */
 export function getFeedback(answer: string, guess: string): LetterColor[] {
-  const feedback: LetterColor[] = ['gray', 'gray', 'gray', 'gray', 'gray']
+  if (answer.length !== guess.length) {
+    throw new Error(
+      `Length mismatch: answer has ${answer.length} letters, ` +
+        `guess has ${guess.length}`
+    )
+  }
+
+  const feedback: LetterColor[] = Array(guess.length).fill('gray')
   const answerLetters = new Map<string, number>()
 
   // Count available letters in answer
@@ -35,7 +44,7 @@ export function getFeedback(answer: string, guess: string): LetterColor[] {
   }
 
   // First pass: mark greens and remove from available
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < guess.length; i++) {
     if (answer[i] === guess[i]) {
       feedback[i] = 'green'
       answerLetters.set(answer[i], answerLetters.get(answer[i])! - 1)
@@ -43,7 +52,7 @@ export function getFeedback(answer: string, guess: string): LetterColor[] {
   }
 
   // Second pass: mark yellows and grays
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < guess.length; i++) {
     if (feedback[i] === 'green') continue
 
     const guessLetter = guess[i]
@@ -60,6 +69,45 @@ export function getFeedback(answer: string, guess: string): LetterColor[] {
   return feedback
 }
 
+/**
+ * Calculate Wordle feedback for a guess against an answer
+ * Same as getFeedback but with the natural (guess, answer) argument
+ * order; getFeedback is not symmetric in its arguments, so callers
+ * should prefer this to avoid swapping them by mistake
+ */
+export function feedbackFor(guess: string, answer: string): LetterColor[] {
+  return getFeedback(answer, guess)
+}
+
+/**
+ * Number of distinct feedback patterns for a guess of the given length
+ * (3^length, e.g. 243 for 5 letters)
+ */
+export function feedbackPatternCount(wordLength: number): number {
+  return 3 ** wordLength
+}
+
+/** Base-3 digit for each letter color in a packed feedback code */
+const FEEDBACK_DIGITS: Record<LetterColor, number> = {
+  gray: 0,
+  yellow: 1,
+  green: 2,
+}
+
+/**
+ * Calculate Wordle feedback for a guess against an answer, packed
+ * into a base-3 integer in [0, feedbackPatternCount(guess.length))
+ * The first position is the most significant digit
+ * (GRAY = 0, YELLOW = 1, GREEN = 2)
+ */
+export function feedbackCodeFor(guess: string, answer: string): number {
+  let code = 0
+  for (const color of feedbackFor(guess, answer)) {
+    code = code * 3 + FEEDBACK_DIGITS[color]
+  }
+  return code
+}
+
 /**
  * Count occurrences of a letter in a word
  */
@@ -68,7 +116,7 @@ export function countLetterInWord(
   letter: string
 ): number {
   let count = 0
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < word.length; i++) {
     if (word[i] === letter) count++
   }
   return count
@@ -81,9 +129,14 @@ export function matchesFeedback(word: string, entry: GuessEntry): boolean {
   const guess = entry.word
   const feedback = entry.feedback.colors
 
+  // Words of a different length can never match
+  if (word.length !== guess.length) {
+    return false
+  }
+
   // Pre-calculate minimum required counts
   const minRequiredCounts = new Map<string, number>()
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < guess.length; i++) {
     if (feedback[i] === 'green' || feedback[i] === 'yellow') {
       minRequiredCounts.set(
         guess[i],
@@ -93,14 +146,14 @@ export function matchesFeedback(word: string, entry: GuessEntry): boolean {
   }
 
   // Check Green Letters (exact position matches)
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < guess.length; i++) {
     if (feedback[i] === 'green' && word[i] !== guess[i]) {
       return false
     }
   }
 
   // Check Yellow Letters (must not be at forbidden positions)
-  for (let i = 0; i < 5; i++) {
+  for (let i = 0; i < guess.length; i++) {
     if (feedback[i] === 'yellow' && word[i] === guess[i]) {
       return false
     }
@@ -113,10 +166,14 @@ export function matchesFeedback(word: string, entry: GuessEntry): boolean {
     }
   }
 
-  // Check Gray Letters (enforce maximum count)
-  for (let i = 0; i < 5; i++) {
+  // Check Gray Letters (forbidden at their own position, even when the
+  // letter is yellow or green elsewhere, and enforce maximum count)
+  for (let i = 0; i < guess.length; i++) {
     if (feedback[i] === 'gray') {
       const letter = guess[i]
+      if (word[i] === letter) {
+        return false
+      }
       const maxCount = minRequiredCounts.get(letter) || 0
       if (countLetterInWord(word, letter) > maxCount) {
         return false